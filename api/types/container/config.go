@@ -0,0 +1,8 @@
+package container // import "github.com/docker/docker/api/types/container"
+
+// Config contains the configuration data about a container, such as its
+// Image, Cmd and Env. This stub only defines Healthcheck, the piece the
+// daemon's health monitor reads.
+type Config struct {
+	Healthcheck *HealthConfig `json:",omitempty"`
+}
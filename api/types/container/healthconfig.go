@@ -0,0 +1,42 @@
+package container // import "github.com/docker/docker/api/types/container"
+
+import "time"
+
+// HealthConfig holds configuration settings for the HEALTHCHECK feature.
+type HealthConfig struct {
+	// Test is the test to perform to check that the container is healthy.
+	// An empty slice means to inherit the default.
+	// The options are:
+	// {} : inherit healthcheck
+	// {"NONE"} : disable healthcheck
+	// {"CMD", args...} : exec arguments directly
+	// {"CMD-SHELL", command} : run command with system's default shell
+	Test []string `json:",omitempty"`
+
+	// Interval is the time to wait between checks.
+	Interval time.Duration `json:",omitempty"`
+	// Timeout is the time to wait before considering the check to have hung.
+	Timeout time.Duration `json:",omitempty"`
+	// Retries is the number of consecutive failures needed to consider a
+	// container as unhealthy.
+	Retries int `json:",omitempty"`
+	// StartPeriod is the period for the container to initialize before the
+	// health check starts counting retries towards unhealthy.
+	StartPeriod time.Duration `json:",omitempty"`
+
+	// StartupTest, if set, is a probe run in place of Test until it first
+	// succeeds, after which Test takes over as the regular healthcheck.
+	// It is intended for containers whose startup time varies and that
+	// would otherwise need an overly generous Interval/Retries for their
+	// steady-state healthcheck.
+	StartupTest []string `json:",omitempty"`
+	// StartupInterval is the time to wait between startup probe attempts.
+	// Defaults to Interval when unset.
+	StartupInterval time.Duration `json:",omitempty"`
+	// StartupRetries is the number of consecutive startup probe failures
+	// needed to consider the container unhealthy, which also ends the
+	// startup phase. Zero means startup probe failures never end the
+	// startup phase. Unlike Retries, this only applies until the startup
+	// probe's first success.
+	StartupRetries int `json:",omitempty"`
+}
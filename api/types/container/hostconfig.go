@@ -0,0 +1,31 @@
+package container // import "github.com/docker/docker/api/types/container"
+
+import "time"
+
+// HostConfig holds the container's host-dependent configuration: resource
+// limits, mounts, network mode, and so on. This package trims it down to
+// the two settings that drive restarts, RestartPolicy and RestartHooks.
+type HostConfig struct {
+	RestartPolicy RestartPolicy
+	RestartHooks  RestartHooks
+}
+
+// RestartHooks declares commands the daemon runs inside the container around
+// restart transitions, whether triggered by ContainerRestart or by the
+// restart policy. Each hook is optional; unset hooks are skipped.
+type RestartHooks struct {
+	PreStop   *RestartHook
+	PostStop  *RestartHook
+	PreStart  *RestartHook
+	PostStart *RestartHook
+}
+
+// RestartHook describes a single exec probe run around a restart
+// transition.
+type RestartHook struct {
+	// Cmd is the command to run inside the container, in exec form.
+	Cmd []string
+	// Timeout bounds how long the hook may run before it is killed and
+	// treated as a failure. Defaults to 5s when unset.
+	Timeout time.Duration
+}
@@ -0,0 +1,15 @@
+package container // import "github.com/docker/docker/api/types/container"
+
+import "time"
+
+// RestartInfo reports the current exponential-backoff restart state of a
+// container, as surfaced by ContainerInspect. It is only populated when the
+// container's restart policy is "exponential-backoff".
+type RestartInfo struct {
+	// NextRestartAt is the time at which the daemon will next attempt to
+	// restart the container. Zero if no restart is currently pending.
+	NextRestartAt time.Time `json:",omitempty"`
+	// AttemptCount is the number of consecutive restart attempts made since
+	// the backoff counter was last reset by ResetAfter.
+	AttemptCount int
+}
@@ -0,0 +1,121 @@
+package container // import "github.com/docker/docker/api/types/container"
+
+import (
+	"fmt"
+	"time"
+)
+
+// RestartPolicyMode represents the policy name used to decide whether a
+// container should be restarted when it exits.
+type RestartPolicyMode string
+
+// Restart policy modes supported by the daemon.
+const (
+	RestartPolicyDisabled           RestartPolicyMode = ""
+	RestartPolicyAlways             RestartPolicyMode = "always"
+	RestartPolicyOnFailure          RestartPolicyMode = "on-failure"
+	RestartPolicyUnlessStopped      RestartPolicyMode = "unless-stopped"
+	RestartPolicyExponentialBackoff RestartPolicyMode = "exponential-backoff"
+)
+
+// RestartPolicy represents the restart policies of the container.
+type RestartPolicy struct {
+	Name              RestartPolicyMode
+	MaximumRetryCount int
+
+	// BaseDelay is the delay used for the first restart attempt under the
+	// exponential-backoff policy. Defaults to 100ms when unset.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay computed for any given restart attempt under
+	// the exponential-backoff policy. Defaults to 1m when unset.
+	MaxDelay time.Duration
+	// MaxRetries limits the number of consecutive restart attempts under
+	// the exponential-backoff policy. Zero means unlimited, mirroring
+	// MaximumRetryCount for the on-failure policy.
+	MaxRetries int
+	// Jitter is a fraction in the range [0, 1] of the computed delay that is
+	// added or subtracted at random, to avoid many containers restarting in
+	// lockstep after a shared failure (e.g. a node reboot).
+	Jitter float64
+	// ResetAfter is the duration a container must stay running before its
+	// backoff attempt counter is reset to zero. Defaults to MaxDelay when
+	// unset.
+	ResetAfter time.Duration
+
+	// OnUnhealthy, when set, causes the daemon to restart the container
+	// once its healthcheck has reported "unhealthy" for Retries consecutive
+	// checks, in addition to restarting on exit per Name.
+	OnUnhealthy bool
+}
+
+// IsNone indicates whether the container has the "no" restart policy.
+// This means the container will not automatically be restarted when exiting.
+func (rp *RestartPolicy) IsNone() bool {
+	return rp.Name == RestartPolicyDisabled || rp.Name == ""
+}
+
+// IsAlways indicates whether the container has the "always" restart policy.
+// This means the container will always be restarted.
+func (rp *RestartPolicy) IsAlways() bool {
+	return rp.Name == RestartPolicyAlways
+}
+
+// IsOnFailure indicates whether the container has the "on-failure" restart policy.
+// This means the container will be restarted if it exits with a non-zero exit status.
+func (rp *RestartPolicy) IsOnFailure() bool {
+	return rp.Name == RestartPolicyOnFailure
+}
+
+// IsUnlessStopped indicates whether the container has the
+// "unless-stopped" restart policy. This means the container will
+// be restarted unless user has put it to stopped state.
+func (rp *RestartPolicy) IsUnlessStopped() bool {
+	return rp.Name == RestartPolicyUnlessStopped
+}
+
+// IsExponentialBackoff indicates whether the container has the
+// "exponential-backoff" restart policy. This means the delay between
+// restart attempts grows exponentially, up to MaxDelay.
+func (rp *RestartPolicy) IsExponentialBackoff() bool {
+	return rp.Name == RestartPolicyExponentialBackoff
+}
+
+// IsSame compares two RestartPolicy to see if they are the same
+func (rp *RestartPolicy) IsSame(tp *RestartPolicy) bool {
+	return rp.Name == tp.Name && rp.MaximumRetryCount == tp.MaximumRetryCount &&
+		rp.BaseDelay == tp.BaseDelay && rp.MaxDelay == tp.MaxDelay &&
+		rp.MaxRetries == tp.MaxRetries && rp.Jitter == tp.Jitter && rp.ResetAfter == tp.ResetAfter &&
+		rp.OnUnhealthy == tp.OnUnhealthy
+}
+
+// ValidateRestartPolicy validates the given RestartPolicy.
+func ValidateRestartPolicy(policy RestartPolicy) error {
+	switch policy.Name {
+	case RestartPolicyAlways, RestartPolicyUnlessStopped, RestartPolicyDisabled:
+		return nil
+	case RestartPolicyOnFailure:
+		if policy.MaximumRetryCount < 0 {
+			return fmt.Errorf("invalid restart policy: maximum retry count cannot be negative")
+		}
+		return nil
+	case RestartPolicyExponentialBackoff:
+		if policy.BaseDelay < 0 {
+			return fmt.Errorf("invalid restart policy: base delay cannot be negative")
+		}
+		if policy.MaxDelay < 0 {
+			return fmt.Errorf("invalid restart policy: max delay cannot be negative")
+		}
+		if policy.MaxDelay > 0 && policy.BaseDelay > policy.MaxDelay {
+			return fmt.Errorf("invalid restart policy: base delay cannot be greater than max delay")
+		}
+		if policy.Jitter < 0 || policy.Jitter > 1 {
+			return fmt.Errorf("invalid restart policy: jitter must be between 0 and 1")
+		}
+		if policy.MaxRetries < 0 {
+			return fmt.Errorf("invalid restart policy: max retries cannot be negative")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid restart policy: unknown policy %q", policy.Name)
+	}
+}
@@ -0,0 +1,35 @@
+package container // import "github.com/docker/docker/api/types/container"
+
+// StopOptions holds the options to stop or restart a container.
+type StopOptions struct {
+	// Signal (optional) is the signal to send to stop the container.
+	Signal string `json:",omitempty"`
+	// Timeout (optional) is the timeout (in seconds) to wait for the
+	// container to stop before killing it. Negative values are unlimited.
+	Timeout *int `json:",omitempty"`
+	// Mode selects how ContainerRestart transitions the container.
+	//
+	// The zero value runs the default restart: stop (and, if needed, kill)
+	// the container, then start a brand new process instance.
+	//
+	// "in-place" keeps the container's network sandbox and mounted volume
+	// handles allocated across the restart, starting the new process
+	// instance in the existing sandbox instead of tearing it down and
+	// recreating it. This avoids IP churn and the brief window where an
+	// autoremoved container would otherwise disappear.
+	Mode RestartMode `json:",omitempty"`
+}
+
+// RestartMode selects the strategy ContainerRestart uses to bring the
+// container's process back up.
+type RestartMode string
+
+const (
+	// RestartModeDefault stops the container and starts a fresh process
+	// instance, tearing down and reallocating its network sandbox.
+	RestartModeDefault RestartMode = ""
+	// RestartModeInPlace stops the container's main process but keeps its
+	// network sandbox and mounted volumes allocated, then starts a new
+	// process instance reusing them.
+	RestartModeInPlace RestartMode = "in-place"
+)
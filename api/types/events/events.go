@@ -0,0 +1,58 @@
+package events // import "github.com/docker/docker/api/types/events"
+
+import "time"
+
+// Action is used for filtering and messages to define what
+// kind of action triggered the event.
+type Action string
+
+// List of known event actions: container creation, the start/restart/die
+// lifecycle, and the four restart-hook actions emitted around a restart
+// transition.
+const (
+	ActionCreate        Action = "create"
+	ActionStart         Action = "start"
+	ActionRestart       Action = "restart"
+	ActionDie           Action = "die"
+	ActionHookPreStop   Action = "hook_prestop"
+	ActionHookPostStop  Action = "hook_poststop"
+	ActionHookPreStart  Action = "hook_prestart"
+	ActionHookPostStart Action = "hook_poststart"
+)
+
+// Type is used for filtering events based on the object they're about, e.g.
+// container, image, network, or volume.
+type Type = string
+
+// ContainerEventType is the event type used for all container lifecycle
+// events, including restarts and restart hooks.
+const ContainerEventType Type = "container"
+
+// Actor describes something that generates events, like a container, image,
+// or network.
+type Actor struct {
+	ID         string
+	Attributes map[string]string
+}
+
+// Message represents the information an event contains.
+type Message struct {
+	Status string `json:"status,omitempty"`
+	ID     string `json:"id,omitempty"`
+	From   string `json:"from,omitempty"`
+
+	Type   string
+	Action Action
+	Actor  Actor
+
+	// Engine events are local scope. Cluster events are swarm scope.
+	Scope string `json:"scope,omitempty"`
+
+	Time     int64 `json:"time,omitempty"`
+	TimeNano int64 `json:"timeNano,omitempty"`
+}
+
+// TimeValue returns the time in time.Time format for the event.
+func (m *Message) TimeValue() time.Time {
+	return time.Unix(0, m.TimeNano)
+}
@@ -0,0 +1,58 @@
+package container // import "github.com/docker/docker/container"
+
+import (
+	"sync"
+
+	containertypes "github.com/docker/docker/api/types/container"
+	"github.com/sirupsen/logrus"
+)
+
+// Container holds the runtime state the daemon keeps for a single
+// container: its configuration, current State, and the handful of fields
+// the restart-policy and health-monitor code needs to track between calls.
+// The real Container struct is much larger; this trims it to that slice.
+type Container struct {
+	sync.Mutex
+
+	ID              string
+	Config          *containertypes.Config
+	HostConfig      *containertypes.HostConfig
+	State           *State
+	NetworkSettings *NetworkSettings
+
+	// MountIDs holds the mount/volume handle identifiers currently
+	// allocated for this container, keyed by destination path. An in-place
+	// restart reuses these rather than unmounting and remounting them.
+	MountIDs map[string]string
+
+	// RestartCount is the number of times this container has been
+	// automatically restarted by its restart policy. Under the
+	// exponential-backoff policy it is set to the backoff attempt count, so
+	// it drops back when that counter is reset after ResetAfter.
+	RestartCount int
+
+	// HasBeenManuallyStopped is true while a ContainerStop (including the
+	// stop phase of an explicit ContainerRestart) is in flight or has run
+	// since the container's last start. handleContainerExit checks this to
+	// avoid scheduling a backoff-policy restart for an exit the user asked
+	// for; startContainer clears it again once the container is running.
+	HasBeenManuallyStopped bool
+}
+
+// ViewDB is the interface used to persist a snapshot of container state,
+// implemented by the daemon's container store.
+type ViewDB interface {
+	Save(*Container) error
+}
+
+// CheckpointTo saves the current state of the container to the given
+// in-memory store so that ContainerInspect reflects the latest backoff
+// state. Callers must hold the container lock.
+func (container *Container) CheckpointTo(store ViewDB) {
+	if store == nil {
+		return
+	}
+	if err := store.Save(container); err != nil {
+		logrus.WithError(err).WithField("container", container.ID).Warn("could not save container state")
+	}
+}
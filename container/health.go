@@ -0,0 +1,15 @@
+package container // import "github.com/docker/docker/container"
+
+// Health holds the current healthcheck state of a container, and is
+// embedded in container.State. It is persisted to disk as part of the
+// container's checkpoint so that --live-restore can resume monitoring a
+// container (including whether it is still in its startup-probe phase)
+// without losing track of consecutive failures across a daemon restart.
+type Health struct {
+	Status        string // "starting", "healthy" or "unhealthy"
+	FailingStreak int    // Count of consecutive failures
+
+	// InStartupPhase is true while the container is still being checked by
+	// HealthConfig.StartupTest rather than the regular HealthConfig.Test.
+	InStartupPhase bool
+}
@@ -0,0 +1,13 @@
+package container // import "github.com/docker/docker/container"
+
+// NetworkSettings holds the networking state for a container, surfaced to
+// clients via ContainerInspect. SandboxID is the piece the in-place restart
+// path cares about; per-network endpoint settings, ports, and the rest of
+// the real NetworkSettings live elsewhere.
+type NetworkSettings struct {
+	// SandboxID is the ID of the network sandbox backing this container.
+	// An in-place restart (StopOptions.Mode == RestartModeInPlace) reuses
+	// this sandbox rather than allocating a new one, so its value is
+	// stable across such restarts.
+	SandboxID string
+}
@@ -0,0 +1,149 @@
+package container // import "github.com/docker/docker/container"
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	containertypes "github.com/docker/docker/api/types/container"
+)
+
+const (
+	defaultBaseDelay = 100 * time.Millisecond
+	defaultMaxDelay  = time.Minute
+)
+
+// RestartManager tracks the state needed to decide whether, and after how
+// long, a container should be restarted after it exits. A single manager is
+// kept alive for the lifetime of a container so that its attempt count and
+// next-restart time survive across multiple restarts.
+type RestartManager struct {
+	mu sync.Mutex
+
+	policy       containertypes.RestartPolicy
+	attemptCount int
+	lastStarted  time.Time
+	nextRestart  time.Time
+}
+
+// NewRestartManager creates a new RestartManager for the given policy.
+func NewRestartManager(policy containertypes.RestartPolicy) *RestartManager {
+	return &RestartManager{policy: policy}
+}
+
+// RestoreState seeds the manager's attempt count and pending-restart time
+// from previously persisted state. It is used on daemon startup with
+// --live-restore to pick up where the previous daemon process left off.
+func (rm *RestartManager) RestoreState(attemptCount int, info containertypes.RestartInfo) {
+	rm.mu.Lock()
+	rm.attemptCount = attemptCount
+	rm.nextRestart = info.NextRestartAt
+	rm.mu.Unlock()
+}
+
+// SetPolicy updates the policy used to compute subsequent restart delays.
+func (rm *RestartManager) SetPolicy(policy containertypes.RestartPolicy) {
+	rm.mu.Lock()
+	rm.policy = policy
+	rm.mu.Unlock()
+}
+
+// AttemptCount returns the number of consecutive restart attempts made since
+// the backoff counter was last reset.
+func (rm *RestartManager) AttemptCount() int {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	return rm.attemptCount
+}
+
+// NextRestartAt returns the time at which the next restart attempt is
+// scheduled to run. It is the zero Time if no restart is currently pending.
+func (rm *RestartManager) NextRestartAt() time.Time {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	return rm.nextRestart
+}
+
+// NotifyStarted records that the container has just started, which is used
+// to decide whether the backoff counter should be reset the next time the
+// container exits. It also clears the pending restart time, since the
+// restart it was waiting for has now happened.
+func (rm *RestartManager) NotifyStarted(startedAt time.Time) {
+	rm.mu.Lock()
+	rm.lastStarted = startedAt
+	rm.nextRestart = time.Time{}
+	rm.mu.Unlock()
+}
+
+// ShouldRestart decides whether the container should be restarted following
+// an exit, and if so, how long the daemon should wait before doing so.
+func (rm *RestartManager) ShouldRestart(exitedAt time.Time) (shouldRestart bool, delay time.Duration) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	if rm.policy.Name != containertypes.RestartPolicyExponentialBackoff {
+		return false, 0
+	}
+
+	resetAfter := rm.policy.ResetAfter
+	if resetAfter == 0 {
+		resetAfter = rm.policy.MaxDelay
+		if resetAfter <= 0 {
+			resetAfter = defaultMaxDelay
+		}
+	}
+	if !rm.lastStarted.IsZero() && resetAfter > 0 && exitedAt.Sub(rm.lastStarted) >= resetAfter {
+		rm.attemptCount = 0
+	}
+
+	if rm.policy.MaxRetries > 0 && rm.attemptCount >= rm.policy.MaxRetries {
+		rm.nextRestart = time.Time{}
+		return false, 0
+	}
+
+	delay = backoffDelay(rm.policy, rm.attemptCount)
+	rm.attemptCount++
+	rm.nextRestart = exitedAt.Add(delay)
+	return true, delay
+}
+
+// backoffDelay computes min(MaxDelay, BaseDelay*2^n) for the given attempt
+// count n, then applies jitter of +/- policy.Jitter as a fraction of that
+// delay, re-clamping to MaxDelay afterwards so jitter can never push the
+// result past the cap the policy promises.
+func backoffDelay(policy containertypes.RestartPolicy, attempt int) time.Duration {
+	base := policy.BaseDelay
+	if base <= 0 {
+		base = defaultBaseDelay
+	}
+	max := policy.MaxDelay
+	if max <= 0 {
+		max = defaultMaxDelay
+	}
+
+	delay := base * time.Duration(1<<uint(attempt))
+	if delay <= 0 || delay > max {
+		delay = max
+	}
+
+	if policy.Jitter > 0 {
+		jitter := policy.Jitter
+		if jitter > 1 {
+			jitter = 1
+		}
+		spread := float64(delay) * jitter
+		delay += time.Duration(spread*rand.Float64()*2 - spread)
+		if delay < 0 {
+			delay = 0
+		}
+		if delay > max {
+			delay = max
+		}
+	}
+	return delay
+}
+
+func (rm *RestartManager) String() string {
+	return fmt.Sprintf("RestartManager(policy=%s, attempt=%d, next=%s)", rm.policy.Name, rm.attemptCount, rm.nextRestart)
+}
@@ -0,0 +1,37 @@
+package container // import "github.com/docker/docker/container"
+
+import (
+	"sync"
+
+	containertypes "github.com/docker/docker/api/types/container"
+)
+
+// State holds the current runtime state of a container, and is embedded in
+// container.Container. Only the fields the restart and health code reads
+// are defined here; the rest of the daemon's container state is omitted.
+type State struct {
+	sync.Mutex
+
+	Running bool
+	Paused  bool
+
+	// RestartInfo reports the exponential-backoff restart state of the
+	// container, surfaced to clients via ContainerInspect.
+	RestartInfo containertypes.RestartInfo
+
+	// LastHooks records the outcome of the most recent run of each restart
+	// hook, surfaced to clients via ContainerInspect.
+	LastHooks []HookResult
+
+	// Health holds the container's healthcheck/startup-probe state.
+	Health *Health
+}
+
+// HookResult records the outcome of a single restart-hook execution.
+type HookResult struct {
+	Name     string // one of "prestop", "poststop", "prestart", "poststart"
+	ExitCode int
+	Stdout   string
+	Stderr   string
+	Err      string `json:",omitempty"`
+}
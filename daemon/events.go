@@ -0,0 +1,24 @@
+package daemon // import "github.com/docker/docker/daemon"
+
+import (
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/container"
+)
+
+// LogContainerEvent generates an event related to a container with
+// empty attributes.
+func (daemon *Daemon) LogContainerEvent(c *container.Container, action events.Action) {
+	daemon.LogContainerEventWithAttributes(c, action, map[string]string{})
+}
+
+// LogContainerEventWithAttributes generates an event related to a
+// container with specific given attributes.
+func (daemon *Daemon) LogContainerEventWithAttributes(c *container.Container, action events.Action, attributes map[string]string) {
+	attributes["name"] = c.ID
+
+	actor := events.Actor{
+		ID:         c.ID,
+		Attributes: attributes,
+	}
+	daemon.EventsService.Log(action, events.ContainerEventType, actor)
+}
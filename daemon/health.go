@@ -0,0 +1,153 @@
+package daemon // import "github.com/docker/docker/daemon"
+
+import (
+	"context"
+	"time"
+
+	containertypes "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/container"
+	"github.com/sirupsen/logrus"
+)
+
+// ensureHealthState initializes c.State.Health the first time it is probed,
+// starting in the startup phase whenever a StartupTest is configured.
+func ensureHealthState(c *container.Container) *container.Health {
+	if c.State.Health == nil {
+		c.State.Health = &container.Health{
+			Status:         "starting",
+			InStartupPhase: len(c.Config.Healthcheck.StartupTest) > 0,
+		}
+	}
+	return c.State.Health
+}
+
+// nextProbe returns the test command and interval to use for this health
+// check iteration: the StartupTest/StartupInterval while the container is
+// still in its startup phase, falling back to the regular Test/Interval
+// once that phase has ended (or if no StartupTest is configured at all).
+func nextProbe(cfg *containertypes.HealthConfig, health *container.Health) (test []string, interval time.Duration) {
+	if health.InStartupPhase && len(cfg.StartupTest) > 0 {
+		interval = cfg.StartupInterval
+		if interval <= 0 {
+			interval = cfg.Interval
+		}
+		return cfg.StartupTest, interval
+	}
+	return cfg.Test, cfg.Interval
+}
+
+// recordProbeResult updates c.State.Health following a single probe run,
+// and restarts the container when its restart policy has OnUnhealthy set
+// and it has just exhausted its consecutive-failure budget.
+//
+// c.State.Health is checkpointed to disk as part of the container's normal
+// state persistence, so a daemon started with --live-restore resumes in
+// the same phase (startup vs. regular) and failing streak it was in before
+// the restart, rather than re-running the startup probe from scratch.
+func (daemon *Daemon) recordProbeResult(ctx context.Context, c *container.Container, healthy bool) {
+	cfg := c.Config.Healthcheck
+	health := ensureHealthState(c)
+
+	c.Lock()
+	defer c.Unlock()
+
+	startupExhausted := false
+	if health.InStartupPhase {
+		if healthy {
+			health.InStartupPhase = false
+			health.FailingStreak = 0
+		} else if cfg.StartupRetries > 0 && health.FailingStreak+1 >= cfg.StartupRetries {
+			// Exhausting the startup probe's retries is reported the same
+			// way as exhausting the regular healthcheck's retries: the
+			// container becomes unhealthy rather than restarting the
+			// startup phase indefinitely.
+			health.InStartupPhase = false
+			startupExhausted = true
+		}
+	}
+
+	if healthy {
+		health.Status = "healthy"
+		health.FailingStreak = 0
+		c.CheckpointTo(daemon.containersReplica)
+		return
+	}
+
+	health.FailingStreak++
+	if health.InStartupPhase || (!startupExhausted && health.FailingStreak < cfg.Retries) {
+		c.CheckpointTo(daemon.containersReplica)
+		return
+	}
+
+	health.Status = "unhealthy"
+	c.CheckpointTo(daemon.containersReplica)
+
+	if !c.HostConfig.RestartPolicy.OnUnhealthy {
+		return
+	}
+
+	go func() {
+		if err := daemon.containerRestart(ctx, c, containertypes.StopOptions{}); err != nil {
+			logrus.WithError(err).WithField("container", c.ID).
+				Warn("failed to restart container after consecutive unhealthy healthchecks")
+			return
+		}
+		// Only emit the restart event once the restart has actually
+		// happened, so containers that merely have a healthcheck (without
+		// opting into OnUnhealthy) never see a misleading restart event.
+		daemon.LogContainerEventWithAttributes(c, events.ActionRestart, map[string]string{"reason": "unhealthy"})
+	}()
+}
+
+// monitorHealth runs c's configured healthcheck in a loop, picking the
+// startup or regular probe via nextProbe on each iteration, until c stops
+// running or ctx is done. It is the loop that makes StartupTest/
+// StartupInterval/StartupRetries (and OnUnhealthy) actually take effect.
+func (daemon *Daemon) monitorHealth(ctx context.Context, c *container.Container) {
+	cfg := c.Config.Healthcheck
+	if cfg == nil || len(cfg.Test) == 0 {
+		return
+	}
+
+	health := ensureHealthState(c)
+
+	for {
+		test, interval := nextProbe(cfg, health)
+		if interval <= 0 {
+			interval = 30 * time.Second
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		if !c.State.Running {
+			return
+		}
+
+		timeout := cfg.Timeout
+		if timeout <= 0 {
+			timeout = 30 * time.Second
+		}
+		probeCtx, cancel := context.WithTimeout(ctx, timeout)
+		_, _, exitCode, err := daemon.execInContainer(probeCtx, c, test)
+		cancel()
+
+		daemon.recordProbeResult(ctx, c, err == nil && exitCode == 0)
+	}
+}
+
+// startHealthMonitor starts monitorHealth in the background for c if it
+// has a healthcheck configured. Its only caller is startContainer in
+// start.go, which every start and restart path in this package goes
+// through. Nothing in this package calls it for a running container that
+// is restored on daemon startup with --live-restore.
+func (daemon *Daemon) startHealthMonitor(c *container.Container) {
+	if c.Config == nil || c.Config.Healthcheck == nil || len(c.Config.Healthcheck.Test) == 0 {
+		return
+	}
+	go daemon.monitorHealth(context.Background(), c)
+}
@@ -0,0 +1,117 @@
+package daemon // import "github.com/docker/docker/daemon"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	containertypes "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/container"
+	"github.com/sirupsen/logrus"
+)
+
+// restartManagers holds the live container.RestartManager for every
+// container that has an exponential-backoff restart policy, keyed by
+// container ID. It survives daemon restarts when --live-restore is set by
+// being rebuilt from the persisted container.RestartCount and
+// container.State.RestartInfo the first time a container's manager is
+// needed after the restart, see restartManagerFor.
+var restartManagers = struct {
+	mu sync.Mutex
+	m  map[string]*container.RestartManager
+}{m: make(map[string]*container.RestartManager)}
+
+// restartManagerFor returns the RestartManager for c, creating one seeded
+// from its persisted restart state if this is the first time it is
+// requested after a daemon restart.
+func restartManagerFor(c *container.Container) *container.RestartManager {
+	restartManagers.mu.Lock()
+	defer restartManagers.mu.Unlock()
+
+	rm, ok := restartManagers.m[c.ID]
+	if !ok {
+		rm = container.NewRestartManager(c.HostConfig.RestartPolicy)
+		rm.RestoreState(c.RestartCount, c.State.RestartInfo)
+		restartManagers.m[c.ID] = rm
+	}
+	return rm
+}
+
+// ProcessExit is called by the containerd task-exit event supervisor
+// whenever a container's process exits, whether on its own or as a result
+// of ContainerStop/ContainerKill. It is the single place that drives the
+// exponential-backoff restart policy.
+func (daemon *Daemon) ProcessExit(c *container.Container, exitedAt time.Time) {
+	c.Lock()
+	c.State.Running = false
+	c.Unlock()
+
+	daemon.handleContainerExit(c, exitedAt)
+}
+
+// handleContainerExit runs the restart-policy decision for c after it has
+// exited, scheduling a delayed restart when the exponential-backoff policy
+// calls for one.
+func (daemon *Daemon) handleContainerExit(c *container.Container, exitedAt time.Time) {
+	if !c.HostConfig.RestartPolicy.IsExponentialBackoff() {
+		return
+	}
+
+	c.Lock()
+	manuallyStopped := c.HasBeenManuallyStopped
+	c.Unlock()
+	if manuallyStopped {
+		// The exit was caused by ContainerStop, or by the stop phase of an
+		// explicit ContainerRestart, not by the container crashing on its
+		// own; leave it stopped (or let the explicit restart's own start
+		// step bring it back up) rather than also scheduling a
+		// backoff-policy restart for the same exit.
+		return
+	}
+
+	rm := restartManagerFor(c)
+	should, delay := rm.ShouldRestart(exitedAt)
+
+	c.Lock()
+	c.RestartCount = rm.AttemptCount()
+	c.State.RestartInfo = containertypes.RestartInfo{
+		NextRestartAt: rm.NextRestartAt(),
+		AttemptCount:  rm.AttemptCount(),
+	}
+	c.CheckpointTo(daemon.containersReplica)
+	c.Unlock()
+
+	if !should {
+		return
+	}
+
+	go func() {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-daemon.shutdownCtx().Done():
+			return
+		}
+
+		// The container already exited on its own, so there is nothing left
+		// to stop; runRestartTransition still runs the configured
+		// PostStop/PreStart/PostStart hooks around the (re)start, and a
+		// failing PreStart hook aborts it the same way it would for a
+		// manually triggered ContainerRestart.
+		restartCtx := context.Background()
+		err := daemon.runRestartTransition(restartCtx, c, func(ctx context.Context) error {
+			return daemon.startContainer(ctx, c, "", "", true)
+		})
+		if err != nil {
+			logrus.WithError(err).WithField("container", c.ID).
+				Warn("exponential-backoff restart manager failed to restart container")
+		} else {
+			rm.NotifyStarted(time.Now())
+			c.Lock()
+			c.State.RestartInfo.NextRestartAt = rm.NextRestartAt()
+			c.CheckpointTo(daemon.containersReplica)
+			c.Unlock()
+		}
+	}()
+}
@@ -0,0 +1,49 @@
+package daemon // import "github.com/docker/docker/daemon"
+
+import (
+	"context"
+	"fmt"
+
+	containertypes "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/container"
+)
+
+// ContainerRestart stops and starts a container. It attempts to
+// gracefully stop the container within the given timeout, forcefully
+// stopping it if the timeout is exceeded. If given a timeout < 0, wait
+// forever for a graceful stop.
+func (daemon *Daemon) ContainerRestart(ctx context.Context, name string, options containertypes.StopOptions) error {
+	c, err := daemon.GetContainer(name)
+	if err != nil {
+		return err
+	}
+
+	if options.Mode == containertypes.RestartModeInPlace {
+		err = daemon.containerRestartInPlace(ctx, c, options)
+	} else {
+		err = daemon.containerRestart(ctx, c, options)
+	}
+	if err != nil {
+		return err
+	}
+	daemon.LogContainerEvent(c, events.ActionRestart)
+	return nil
+}
+
+// containerRestart is the default restart mode: stop the container (and,
+// if needed, kill it) and start a brand new process instance, tearing down
+// and reallocating its network sandbox in the process. Any configured
+// RestartHooks run around the transition; a failing PreStart hook aborts
+// the restart, leaving the container stopped.
+func (daemon *Daemon) containerRestart(ctx context.Context, c *container.Container, options containertypes.StopOptions) error {
+	return daemon.runRestartTransition(ctx, c, func(ctx context.Context) error {
+		if err := daemon.stopContainer(ctx, c, options); err != nil {
+			return fmt.Errorf("could not stop container %s: %w", c.ID, err)
+		}
+		if err := daemon.startContainer(ctx, c, "", "", true); err != nil {
+			return fmt.Errorf("could not start container %s: %w", c.ID, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,136 @@
+package daemon // import "github.com/docker/docker/daemon"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	containertypes "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/container"
+)
+
+const defaultRestartHookTimeout = 5 * time.Second
+
+// execInContainer runs cmd inside c to completion, the same way `docker
+// exec` does, and returns its captured output and exit code. It is built
+// on the existing exec subsystem (ContainerExecCreate/Start/Inspect)
+// rather than a bespoke mechanism, so restart hooks are subject to the
+// same namespace and capability rules as any other exec.
+func (daemon *Daemon) execInContainer(ctx context.Context, c *container.Container, cmd []string) (stdout, stderr string, exitCode int, err error) {
+	execID, err := daemon.ContainerExecCreate(c.ID, &types.ExecConfig{
+		Cmd:          cmd,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return "", "", 0, fmt.Errorf("creating exec for hook: %w", err)
+	}
+
+	var outBuf, errBuf bytes.Buffer
+	if err := daemon.ContainerExecStart(ctx, execID, nil, &outBuf, &errBuf); err != nil {
+		return outBuf.String(), errBuf.String(), 0, fmt.Errorf("running hook: %w", err)
+	}
+
+	inspect, err := daemon.ContainerExecInspect(execID)
+	if err != nil {
+		return outBuf.String(), errBuf.String(), 0, fmt.Errorf("inspecting hook exec: %w", err)
+	}
+
+	return outBuf.String(), errBuf.String(), inspect.ExitCode, nil
+}
+
+// runRestartHook execs hook inside c, blocking until it completes, times
+// out, or the context is cancelled. The outcome is recorded on c.State and
+// emitted as an event under the given action, mirroring how other
+// container lifecycle transitions are reported.
+func (daemon *Daemon) runRestartHook(ctx context.Context, c *container.Container, name string, hook *containertypes.RestartHook, action events.Action) error {
+	if hook == nil {
+		return nil
+	}
+
+	timeout := hook.Timeout
+	if timeout <= 0 {
+		timeout = defaultRestartHookTimeout
+	}
+	hookCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stdout, stderr, exitCode, err := daemon.execInContainer(hookCtx, c, hook.Cmd)
+
+	result := container.HookResult{
+		Name:     name,
+		ExitCode: exitCode,
+		Stdout:   stdout,
+		Stderr:   stderr,
+	}
+	if err != nil {
+		result.Err = err.Error()
+	}
+
+	c.Lock()
+	replaced := false
+	for i := range c.State.LastHooks {
+		if c.State.LastHooks[i].Name == name {
+			c.State.LastHooks[i] = result
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		c.State.LastHooks = append(c.State.LastHooks, result)
+	}
+	c.Unlock()
+
+	attributes := map[string]string{
+		"exitCode": strconv.Itoa(exitCode),
+		"stdout":   stdout,
+		"stderr":   stderr,
+	}
+	if err != nil {
+		attributes["error"] = err.Error()
+	}
+	daemon.LogContainerEventWithAttributes(c, action, attributes)
+
+	if err != nil {
+		return fmt.Errorf("restart hook %q failed: %w", name, err)
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("restart hook %q exited with code %d", name, exitCode)
+	}
+	return nil
+}
+
+// runRestartTransition runs the configured PreStop/PostStop hooks around
+// stopping c and the PreStart/PostStart hooks around starting it again. A
+// failing PreStart hook aborts the restart: the container is left stopped
+// and the error is returned to the caller, using the same cancellation
+// semantics as a restart whose context is cancelled mid-flight.
+func (daemon *Daemon) runRestartTransition(ctx context.Context, c *container.Container, stopAndStart func(context.Context) error) error {
+	hooks := c.HostConfig.RestartHooks
+
+	if c.State.Running {
+		if err := daemon.runRestartHook(ctx, c, "prestop", hooks.PreStop, events.ActionHookPreStop); err != nil {
+			return err
+		}
+	}
+
+	if err := stopAndStart(ctx); err != nil {
+		return err
+	}
+
+	if err := daemon.runRestartHook(ctx, c, "poststop", hooks.PostStop, events.ActionHookPostStop); err != nil {
+		return err
+	}
+
+	if err := daemon.runRestartHook(ctx, c, "prestart", hooks.PreStart, events.ActionHookPreStart); err != nil {
+		// A failing PreStart hook aborts the restart; the container stays
+		// stopped rather than being started into a state its hook refused.
+		return err
+	}
+
+	return daemon.runRestartHook(ctx, c, "poststart", hooks.PostStart, events.ActionHookPostStart)
+}
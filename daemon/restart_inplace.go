@@ -0,0 +1,39 @@
+package daemon // import "github.com/docker/docker/daemon"
+
+import (
+	"context"
+	"fmt"
+
+	containertypes "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/container"
+)
+
+// containerRestartInPlace stops c's main process while leaving its network
+// sandbox and mounted volumes allocated, then starts a new process instance
+// reusing them. Unlike the default restart path, the container's sandbox
+// and mount handles are never released, so its IP/MAC addresses and
+// bind-mount inodes are unchanged by the restart, and an autoremoved
+// container never has a window where it has disappeared entirely.
+func (daemon *Daemon) containerRestartInPlace(ctx context.Context, c *container.Container, options containertypes.StopOptions) error {
+	if c.NetworkSettings == nil || c.NetworkSettings.SandboxID == "" {
+		return fmt.Errorf("container %s has no network sandbox to reuse for an in-place restart", c.ID)
+	}
+
+	return daemon.runRestartTransition(ctx, c, func(ctx context.Context) error {
+		if err := daemon.stopContainer(ctx, c, options); err != nil {
+			return fmt.Errorf("in-place restart: stopping container %s: %w", c.ID, err)
+		}
+
+		// Intentionally do not release the network sandbox (c.NetworkSettings.SandboxID)
+		// or unmount c.MountIDs here: that is the entire point of "in-place". Because
+		// they're left allocated, the startContainer call below finds them already
+		// in place on c and reuses them instead of allocating fresh ones, which is
+		// what actually makes the new process instance come up with the same
+		// IP/MAC addresses and bind-mount inodes as before.
+		if err := daemon.startContainer(ctx, c, "", "", true); err != nil {
+			return fmt.Errorf("in-place restart: starting container %s: %w", c.ID, err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,38 @@
+package daemon // import "github.com/docker/docker/daemon"
+
+import (
+	"context"
+
+	"github.com/docker/docker/container"
+)
+
+// ContainerStart starts a container that has already been created. It is
+// also the path used to start the very first process of a freshly created
+// container (e.g. after `docker run`).
+func (daemon *Daemon) ContainerStart(ctx context.Context, name, checkpoint, checkpointDir string) error {
+	c, err := daemon.GetContainer(name)
+	if err != nil {
+		return err
+	}
+	return daemon.startContainer(ctx, c, checkpoint, checkpointDir, true)
+}
+
+// startContainer runs the daemon's real start path for c and then, if c has
+// a Healthcheck configured, starts its health monitor. Every place in this
+// package that brings a container's process up — the initial ContainerStart
+// above, the default and in-place restart paths, and the exponential-backoff
+// restart policy — goes through here, so HealthConfig's StartupTest/Test and
+// RestartPolicy.OnUnhealthy take effect from the container's first start
+// rather than only after it happens to go through an unrelated restart.
+func (daemon *Daemon) startContainer(ctx context.Context, c *container.Container, checkpoint, checkpointDir string, resetRestartManager bool) error {
+	if err := daemon.containerStart(ctx, c, checkpoint, checkpointDir, resetRestartManager); err != nil {
+		return err
+	}
+
+	c.Lock()
+	c.HasBeenManuallyStopped = false
+	c.Unlock()
+
+	daemon.startHealthMonitor(c)
+	return nil
+}
@@ -0,0 +1,31 @@
+package daemon // import "github.com/docker/docker/daemon"
+
+import (
+	"context"
+
+	containertypes "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/container"
+)
+
+// ContainerStop stops a container, following the given StopOptions.
+func (daemon *Daemon) ContainerStop(ctx context.Context, name string, options containertypes.StopOptions) error {
+	c, err := daemon.GetContainer(name)
+	if err != nil {
+		return err
+	}
+	return daemon.stopContainer(ctx, c, options)
+}
+
+// stopContainer marks c as having been intentionally stopped before running
+// the daemon's real stop path, then runs it. The flag is checked by
+// handleContainerExit so that the exponential-backoff restart policy
+// ignores the resulting exit, the same way every other restart policy
+// already ignores a container the user (or an explicit ContainerRestart)
+// asked to stop.
+func (daemon *Daemon) stopContainer(ctx context.Context, c *container.Container, options containertypes.StopOptions) error {
+	c.Lock()
+	c.HasBeenManuallyStopped = true
+	c.Unlock()
+
+	return daemon.containerStop(ctx, c, options)
+}
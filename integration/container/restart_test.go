@@ -147,6 +147,111 @@ func TestDaemonRestartKillContainers(t *testing.T) {
 	}
 }
 
+// TestDaemonRestartExponentialBackoffAcrossRestart asserts that a container
+// using the "exponential-backoff" restart policy keeps its backoff attempt
+// count across a daemon restart under --live-restore, rather than having
+// it reset to zero.
+func TestDaemonRestartExponentialBackoffAcrossRestart(t *testing.T) {
+	skip.If(t, testEnv.IsRemoteDaemon, "cannot start daemon on remote test run")
+	skip.If(t, testEnv.DaemonInfo.OSType == "windows")
+	skip.If(t, testEnv.IsRootless, "rootless mode doesn't support live-restore")
+
+	ctx := testutil.StartSpan(baseContext, t)
+
+	d := daemon.New(t)
+	apiClient := d.NewClientT(t)
+
+	d.StartWithBusybox(ctx, t, "--iptables=false", "--live-restore")
+	defer d.Stop(t)
+
+	resp, err := apiClient.ContainerCreate(ctx, &container.Config{
+		Image: "busybox",
+		Cmd:   []string{"false"},
+	}, &container.HostConfig{
+		RestartPolicy: container.RestartPolicy{
+			Name:      "exponential-backoff",
+			BaseDelay: 2 * time.Second,
+			MaxDelay:  30 * time.Second,
+		},
+	}, nil, nil, "")
+	assert.NilError(t, err)
+	defer apiClient.ContainerRemove(ctx, resp.ID, container.RemoveOptions{Force: true})
+
+	err = apiClient.ContainerStart(ctx, resp.ID, container.StartOptions{})
+	assert.NilError(t, err)
+
+	// Wait for the container to exit once and for the daemon to schedule
+	// its first backoff restart before killing the daemon.
+	var firstAttempt int
+	poll.WaitOn(t, func(log poll.LogT) poll.Result {
+		inspect, err := apiClient.ContainerInspect(ctx, resp.ID)
+		if err != nil {
+			return poll.Error(err)
+		}
+		if inspect.State.RestartInfo.AttemptCount == 0 {
+			return poll.Continue("waiting for first restart attempt to be scheduled")
+		}
+		firstAttempt = inspect.State.RestartInfo.AttemptCount
+		return poll.Success()
+	}, poll.WithDelay(100*time.Millisecond), poll.WithTimeout(30*time.Second))
+
+	d.Kill()
+	d.Start(t, "--iptables=false", "--live-restore")
+
+	inspect, err := apiClient.ContainerInspect(ctx, resp.ID)
+	assert.NilError(t, err)
+	assert.Check(t, inspect.State.RestartInfo.AttemptCount >= firstAttempt,
+		"restart attempt count should not reset after a daemon restart with --live-restore")
+}
+
+// TestContainerRestartOnUnhealthy verifies that a container with
+// HostConfig.RestartPolicy.OnUnhealthy restarts once its healthcheck has
+// reported "unhealthy" for Retries consecutive checks, and that the
+// restart is recorded as an events.ActionRestart event carrying a
+// "reason=unhealthy" attribute, distinguishing it from a restart-policy or
+// manual restart.
+func TestContainerRestartOnUnhealthy(t *testing.T) {
+	ctx := setupTest(t)
+	apiClient := testEnv.APIClient()
+
+	testutil.StartSpan(ctx, t)
+
+	cID := testContainer.Run(ctx, t, apiClient,
+		testContainer.WithCmd("top"),
+		testContainer.WithHealthCheck(&container.HealthConfig{
+			Test:     []string{"CMD-SHELL", "exit 1"},
+			Interval: 200 * time.Millisecond,
+			Retries:  2,
+		}),
+		func(c *testContainer.TestContainerConfig) {
+			c.HostConfig.RestartPolicy = container.RestartPolicy{OnUnhealthy: true}
+		},
+	)
+	defer func() {
+		err := apiClient.ContainerRemove(ctx, cID, container.RemoveOptions{Force: true})
+		if t.Failed() && err != nil {
+			t.Logf("Cleaning up test container failed with error: %v", err)
+		}
+	}()
+
+	messages, errs := apiClient.Events(ctx, types.EventsOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("container", cID),
+			filters.Arg("event", string(events.ActionRestart)),
+		),
+	})
+
+	select {
+	case m := <-messages:
+		assert.Check(t, is.Equal(m.Actor.ID, cID))
+		assert.Check(t, is.Equal(m.Actor.Attributes["reason"], "unhealthy"))
+	case err := <-errs:
+		assert.NilError(t, err)
+	case <-time.After(15 * time.Second):
+		t.Errorf("timeout waiting for restart-on-unhealthy event")
+	}
+}
+
 func pollForNewHealthCheck(ctx context.Context, client *client.Client, startTime time.Time, containerID string) func(log poll.LogT) poll.Result {
 	return func(log poll.LogT) poll.Result {
 		inspect, err := client.ContainerInspect(ctx, containerID)
@@ -288,3 +393,84 @@ func TestContainerRestartWithCancelledRequest(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Check(t, is.Equal(inspect.State.Status, "running"))
 }
+
+// TestContainerRestartInPlacePreservesNetwork verifies that restarting a
+// container with StopOptions{Mode: container.RestartModeInPlace} reuses the
+// container's existing network sandbox, rather than releasing and
+// reallocating it the way a normal restart does. It asserts the container's
+// IP address and the reported sandbox ID are unchanged across the restart.
+func TestContainerRestartInPlacePreservesNetwork(t *testing.T) {
+	ctx := setupTest(t)
+	apiClient := testEnv.APIClient()
+
+	testutil.StartSpan(ctx, t)
+
+	cID := testContainer.Run(ctx, t, apiClient, testContainer.WithCmd("top"))
+	defer func() {
+		err := apiClient.ContainerRemove(ctx, cID, container.RemoveOptions{Force: true})
+		if t.Failed() && err != nil {
+			t.Logf("Cleaning up test container failed with error: %v", err)
+		}
+	}()
+
+	before, err := apiClient.ContainerInspect(ctx, cID)
+	assert.NilError(t, err)
+	sandboxBefore := before.NetworkSettings.SandboxID
+	assert.Check(t, sandboxBefore != "", "expected a network sandbox to be allocated")
+
+	noWaitTimeout := 0
+	err = apiClient.ContainerRestart(ctx, cID, container.StopOptions{
+		Timeout: &noWaitTimeout,
+		Mode:    container.RestartModeInPlace,
+	})
+	assert.NilError(t, err)
+
+	poll.WaitOn(t, testContainer.IsInState(ctx, apiClient, cID, "running"))
+
+	after, err := apiClient.ContainerInspect(ctx, cID)
+	assert.NilError(t, err)
+	assert.Check(t, is.Equal(after.NetworkSettings.SandboxID, sandboxBefore),
+		"in-place restart should reuse the existing network sandbox")
+	assert.Check(t, is.Equal(after.NetworkSettings.IPAddress, before.NetworkSettings.IPAddress),
+		"in-place restart should keep the container's IP address")
+}
+
+// TestContainerRestartWithFailingPreStartHook verifies that a failing
+// PreStart restart hook makes the restart request fail, leaves the container
+// stopped, and that the hook's non-zero exit code is reported through
+// ContainerInspect's State.LastHooks.
+func TestContainerRestartWithFailingPreStartHook(t *testing.T) {
+	ctx := setupTest(t)
+	apiClient := testEnv.APIClient()
+
+	testutil.StartSpan(ctx, t)
+
+	cID := testContainer.Run(ctx, t, apiClient, testContainer.WithCmd("top"), func(c *testContainer.TestContainerConfig) {
+		c.HostConfig.RestartHooks = container.RestartHooks{
+			PreStart: &container.RestartHook{Cmd: []string{"sh", "-c", "exit 1"}},
+		}
+	})
+	defer func() {
+		err := apiClient.ContainerRemove(ctx, cID, container.RemoveOptions{Force: true})
+		if t.Failed() && err != nil {
+			t.Logf("Cleaning up test container failed with error: %v", err)
+		}
+	}()
+
+	noWaitTimeout := 0
+	err := apiClient.ContainerRestart(ctx, cID, container.StopOptions{Timeout: &noWaitTimeout})
+	assert.Check(t, err != nil, "restart should fail when its PreStart hook fails")
+
+	inspect, err := apiClient.ContainerInspect(ctx, cID)
+	assert.NilError(t, err)
+	assert.Check(t, is.Equal(inspect.State.Running, false), "container should remain stopped after a failing PreStart hook")
+
+	var sawPreStart bool
+	for _, h := range inspect.State.LastHooks {
+		if h.Name == "prestart" {
+			sawPreStart = true
+			assert.Check(t, h.ExitCode != 0)
+		}
+	}
+	assert.Check(t, sawPreStart, "expected a recorded prestart hook result")
+}